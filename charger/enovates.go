@@ -3,6 +3,7 @@ package charger
 import (
 	"encoding/binary"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/evcc-io/evcc/api"
@@ -16,6 +17,9 @@ type Enovates struct {
 	conn    *modbus.Connection
 	curr    uint16
 	enabled bool
+
+	mu           sync.Mutex
+	loadShedding bool
 }
 
 const (
@@ -32,6 +36,8 @@ const (
 	enovatesRegEnergy         = 214 // Active Energy Import total
 	enovatesRegStatus         = 301 // Mode 3 state numeric
 	enovatesRegCurrentOffered = 401 // Current Offered
+
+	enovatesContactorTimeout = 10 * time.Second
 )
 
 func init() {
@@ -79,9 +85,38 @@ func NewEnovates(uri, device, comset string, baudrate int, proto modbus.Protocol
 func (wb *Enovates) heartbeat() {
 	for range time.Tick(30 * time.Second) {
 		_, _ = wb.status()
+
+		if shedding, err := wb.loadSheddingActive(); err == nil {
+			wb.mu.Lock()
+			wb.loadShedding = shedding
+			wb.mu.Unlock()
+		}
 	}
 }
 
+// loadSheddingActive reads whether the charger is currently load shedding
+func (wb *Enovates) loadSheddingActive() (bool, error) {
+	b, err := wb.conn.ReadHoldingRegisters(enovatesRegLoadShedding, 1)
+	if err != nil {
+		return false, err
+	}
+
+	return binary.BigEndian.Uint16(b) != 0, nil
+}
+
+// LoadShedding reports the load-shedding state last seen by the heartbeat.
+//
+// TODO(loadpoint): the loadpoint does not consume this yet. Once a charger
+// feature-flag interface for load shedding lands in api, implement it here so
+// the loadpoint can throttle proactively instead of tripping on a hard error
+// once load shedding has already kicked in. Tracked alongside this request.
+func (wb *Enovates) LoadShedding() bool {
+	wb.mu.Lock()
+	defer wb.mu.Unlock()
+
+	return wb.loadShedding
+}
+
 func (wb *Enovates) status() (uint16, error) {
 	b, err := wb.conn.ReadHoldingRegisters(enovatesRegStatus, 1)
 	if err != nil {
@@ -127,14 +162,45 @@ func (wb *Enovates) Enable(enable bool) error {
 		current = wb.curr
 	}
 
+	return wb.writeCurrentOffered(current)
+}
+
+// writeCurrentOffered writes the given current (in mA) to the CurrentOffered register
+func (wb *Enovates) writeCurrentOffered(current uint16) error {
 	_, err := wb.conn.WriteSingleRegister(enovatesRegCurrentOffered, current)
 	return err
 }
 
+// contactorOpen reports whether the contactor is currently open
+func (wb *Enovates) contactorOpen() (bool, error) {
+	b, err := wb.conn.ReadHoldingRegisters(enovatesRegContactor, 1)
+	if err != nil {
+		return false, err
+	}
+
+	return binary.BigEndian.Uint16(b) == 0, nil
+}
+
 // MaxCurrent implements the api.Charger interface
 func (wb *Enovates) MaxCurrent(current int64) error {
+	return wb.MaxCurrentMillis(float64(current))
+}
+
+var _ api.ChargerEx = (*Enovates)(nil)
+
+// MaxCurrentMillis implements the api.ChargerEx interface
+func (wb *Enovates) MaxCurrentMillis(current float64) error {
 	if current < 6 {
-		return fmt.Errorf("invalid current %.1f", float64(current))
+		return fmt.Errorf("invalid current %.1f", current)
+	}
+
+	max, err := wb.MaxAmps()
+	if err != nil {
+		return err
+	}
+
+	if current > float64(max) {
+		return fmt.Errorf("current %.1f exceeds maximum %d", current, max)
 	}
 
 	wb.curr = uint16(current * 1000)
@@ -180,3 +246,94 @@ func (wb *Enovates) MaxAmps() (int, error) {
 	}
 	return int(binary.BigEndian.Uint16(b)), nil
 }
+
+var _ api.PhaseSwitcher = (*Enovates)(nil)
+
+// Phases1p3p implements the api.PhaseSwitcher interface
+func (wb *Enovates) Phases1p3p(phases int) error {
+	current := wb.curr
+
+	enabled, err := wb.Enabled()
+	if err != nil {
+		return err
+	}
+
+	// pause charging and wait for the contactor to open before switching phases
+	if err := wb.writeCurrentOffered(0); err != nil {
+		return err
+	}
+
+	timeout := time.Now().Add(enovatesContactorTimeout)
+	for {
+		open, err := wb.contactorOpen()
+		if err != nil {
+			return err
+		}
+		if open {
+			break
+		}
+
+		if time.Now().After(timeout) {
+			return fmt.Errorf("timeout waiting for contactor to open")
+		}
+
+		time.Sleep(time.Second)
+	}
+
+	if _, err := wb.conn.WriteSingleRegister(enovatesRegPhases, uint16(phases)); err != nil {
+		return err
+	}
+
+	wb.curr = current
+	return wb.Enable(enabled)
+}
+
+var _ api.PhaseGetter = (*Enovates)(nil)
+
+// GetPhases implements the api.PhaseGetter interface
+func (wb *Enovates) GetPhases() (int, error) {
+	b, err := wb.conn.ReadHoldingRegisters(enovatesRegPhases, 1)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(binary.BigEndian.Uint16(b)), nil
+}
+
+var _ api.Diagnosis = (*Enovates)(nil)
+
+// Diagnose implements the api.Diagnosis interface
+func (wb *Enovates) Diagnose() {
+	if b, err := wb.conn.ReadHoldingRegisters(enovatesRegLoadShedding, 1); err == nil {
+		fmt.Printf("Load shedding: %v\n", binary.BigEndian.Uint16(b) != 0)
+	}
+
+	if b, err := wb.conn.ReadHoldingRegisters(enovatesRegLockState, 1); err == nil {
+		fmt.Printf("Lock state: %d\n", binary.BigEndian.Uint16(b))
+	}
+
+	if b, err := wb.conn.ReadHoldingRegisters(enovatesRegLED, 1); err == nil {
+		fmt.Printf("LED index: %d\n", binary.BigEndian.Uint16(b))
+	}
+}
+
+// Unlock releases the connector lock.
+//
+// TODO(ui): not yet callable from the UI. Needs a dedicated charger control
+// interface for connector lock release — api.Resurrector is unrelated, it
+// only wakes a sleeping vehicle before polling SOC. Tracked alongside this
+// request.
+func (wb *Enovates) Unlock() error {
+	_, err := wb.conn.WriteSingleRegister(enovatesRegLockState, 0)
+	return err
+}
+
+// Identify flashes the connector LED so the charger can be located on site.
+//
+// TODO(ui): not yet callable from the UI. Needs a dedicated charger control
+// interface for this — api.Identifier is unrelated, it reads an RFID tag, not
+// LED control. Tracked alongside this request.
+func (wb *Enovates) Identify() error {
+	_, err := wb.conn.WriteSingleRegister(enovatesRegLED, 1)
+	return err
+}